@@ -2,14 +2,17 @@
 package main
 
 import (
+    "context"
     "fmt"
     "log"
     "time"
-    
+
     nestjs "your-org/nestjs-api-sdk"
 )
 
 func main() {
+    ctx := context.Background()
+
     // Initialize SDK
     client := nestjs.NewApiClient(nestjs.ApiClientConfig{
         BaseURL:    "https://api.yourapp.com",
@@ -17,27 +20,23 @@ func main() {
         Timeout:    30 * time.Second,
         Retries:    3,
         RetryDelay: time.Second,
-        Debug:      true,
-    })
+    }, nestjs.WithUserAgent("example-app/1.0"))
 
     // Create users resource
     users := nestjs.NewUsersResource(client)
 
     // List users
-    usersList, err := users.List(map[string]interface{}{
-        "page":  1,
-        "limit": 10,
-    })
+    usersList, err := users.List(ctx, nestjs.ListOpts{Page: 1, Limit: 10})
     if err != nil {
         log.Fatal(err)
     }
     fmt.Println("Users:", usersList.Data)
 
     // Create a user
-    newUser, err := users.Create(map[string]interface{}{
-        "name":     "John Doe",
-        "email":    "john@example.com",
-        "password": "securepassword",
+    newUser, err := users.Create(ctx, nestjs.CreateUserRequest{
+        Name:     "John Doe",
+        Email:    "john@example.com",
+        Password: "securepassword",
     })
     if err != nil {
         if apiErr, ok := err.(*nestjs.ApiError); ok {
@@ -50,10 +49,10 @@ func main() {
     }
     fmt.Println("Created user:", newUser.Data)
 
-    // Custom request
-    customData, err := client.Get("/custom-endpoint")
+    // Custom request, decoded directly into a caller-supplied type
+    customData, err := nestjs.Get[map[string]interface{}](ctx, client, "/custom-endpoint")
     if err != nil {
         log.Fatal(err)
     }
     fmt.Println("Custom data:", customData.Data)
-}
\ No newline at end of file
+}
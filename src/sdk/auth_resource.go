@@ -0,0 +1,42 @@
+// packages/go/auth_resource.go
+package nestjs_sdk
+
+import "context"
+
+// LoginRequest is the payload accepted by AuthResource.Login.
+type LoginRequest struct {
+    Email    string `json:"email"`
+    Password string `json:"password"`
+}
+
+// AuthTokens is the NestJS backend's Auth module JWT access/refresh token
+// pair, as returned by login and refresh.
+type AuthTokens struct {
+    AccessToken  string `json:"accessToken"`
+    RefreshToken string `json:"refreshToken"`
+}
+
+// AuthResource is the sub-client for the NestJS backend's Auth module.
+type AuthResource struct {
+    client *ApiClient
+}
+
+// NewAuthResource builds an AuthResource bound to client.
+func NewAuthResource(client *ApiClient) *AuthResource {
+    return &AuthResource{client: client}
+}
+
+// Login exchanges credentials for an access/refresh token pair.
+func (r *AuthResource) Login(ctx context.Context, req LoginRequest) (*Response[AuthTokens], error) {
+    return Post[AuthTokens](ctx, r.client, "/auth/login", req)
+}
+
+// Refresh exchanges a refresh token for a new access/refresh token pair.
+func (r *AuthResource) Refresh(ctx context.Context, refreshToken string) (*Response[AuthTokens], error) {
+    return Post[AuthTokens](ctx, r.client, "/auth/refresh", map[string]string{"refreshToken": refreshToken})
+}
+
+// Logout invalidates the caller's current session.
+func (r *AuthResource) Logout(ctx context.Context) (*Response[struct{}], error) {
+    return Post[struct{}](ctx, r.client, "/auth/logout", nil)
+}
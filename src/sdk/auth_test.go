@@ -0,0 +1,112 @@
+// packages/go/auth_test.go
+package nestjs_sdk
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+)
+
+// fakeTokenSource hands out a fixed initial token, then a fixed refreshed
+// token, counting how many times each method is called.
+type fakeTokenSource struct {
+    tokenCalls   int
+    refreshCalls int
+}
+
+func (f *fakeTokenSource) Token(ctx context.Context) (string, error) {
+    f.tokenCalls++
+    return "initial-token", nil
+}
+
+func (f *fakeTokenSource) Refresh(ctx context.Context) (string, error) {
+    f.refreshCalls++
+    return "refreshed-token", nil
+}
+
+// TestOAuth2AuthenticatorRefreshesOnceOn401 exercises ApiClient's one-shot
+// 401-refresh-and-retry: a request signed with the stale token is rejected
+// once, OAuth2Authenticator.refresh is called exactly once to obtain a new
+// token, and the retried request (now carrying the new token) succeeds.
+func TestOAuth2AuthenticatorRefreshesOnceOn401(t *testing.T) {
+    var requests int
+
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        requests++
+        auth := r.Header.Get("Authorization")
+
+        switch requests {
+        case 1:
+            if auth != "Bearer initial-token" {
+                t.Errorf("request 1: Authorization = %q, want %q", auth, "Bearer initial-token")
+            }
+            w.WriteHeader(http.StatusUnauthorized)
+            json.NewEncoder(w).Encode(map[string]string{"message": "expired", "code": "TOKEN_EXPIRED"})
+        case 2:
+            if auth != "Bearer refreshed-token" {
+                t.Errorf("request 2: Authorization = %q, want %q", auth, "Bearer refreshed-token")
+            }
+            w.WriteHeader(http.StatusOK)
+            json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+        default:
+            t.Fatalf("unexpected request %d", requests)
+        }
+    }))
+    defer server.Close()
+
+    source := &fakeTokenSource{}
+    client := NewApiClient(ApiClientConfig{
+        BaseURL:       server.URL,
+        Authenticator: &OAuth2Authenticator{Source: source},
+    })
+
+    resp, err := client.GetWithContext(context.Background(), "/resource")
+    if err != nil {
+        t.Fatalf("GetWithContext returned error: %v", err)
+    }
+    if !resp.Success {
+        t.Errorf("resp.Success = false, want true")
+    }
+
+    if requests != 2 {
+        t.Errorf("server received %d requests, want 2 (initial 401 + retried 200)", requests)
+    }
+    if source.refreshCalls != 1 {
+        t.Errorf("Refresh called %d times, want exactly 1", source.refreshCalls)
+    }
+}
+
+// TestOAuth2AuthenticatorDoesNotRefreshTwice verifies a second consecutive
+// 401 (e.g. the refreshed token is also rejected) is surfaced as an error
+// instead of refreshing again and looping.
+func TestOAuth2AuthenticatorDoesNotRefreshTwice(t *testing.T) {
+    var requests int
+
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        requests++
+        w.WriteHeader(http.StatusUnauthorized)
+        json.NewEncoder(w).Encode(map[string]string{"message": "still unauthorized", "code": "TOKEN_EXPIRED"})
+    }))
+    defer server.Close()
+
+    source := &fakeTokenSource{}
+    client := NewApiClient(ApiClientConfig{
+        BaseURL:       server.URL,
+        Authenticator: &OAuth2Authenticator{Source: source},
+        RetryPolicy:   &RetryPolicy{MaxRetries: 0},
+    })
+
+    _, err := client.GetWithContext(context.Background(), "/resource")
+    if err == nil {
+        t.Fatal("expected an error after a second 401, got nil")
+    }
+
+    if requests != 2 {
+        t.Errorf("server received %d requests, want 2 (initial 401 + one refresh-retry 401)", requests)
+    }
+    if source.refreshCalls != 1 {
+        t.Errorf("Refresh called %d times, want exactly 1 (no second refresh attempt)", source.refreshCalls)
+    }
+}
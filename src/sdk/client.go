@@ -2,22 +2,34 @@
 package nestjs_sdk
 
 import (
-    "bytes"
+    "context"
     "encoding/json"
     "fmt"
     "io"
     "net/http"
-    "net/url"
+    "os"
     "time"
+
+    "golang.org/x/time/rate"
 )
 
 type ApiClientConfig struct {
-    BaseURL    string
-    ApiKey     string
-    Timeout    time.Duration
-    Retries    int
-    RetryDelay time.Duration
-    Debug      bool
+    BaseURL       string
+    ApiKey        string
+    Authenticator Authenticator
+    Timeout       time.Duration
+    Retries       int
+    RetryDelay    time.Duration
+
+    // RetryPolicy overrides the Retries/RetryDelay-based policy entirely
+    // when set, including an explicit RetryPolicy{MaxRetries: 0} meaning "no
+    // retries" -- leave nil to build the policy from Retries/RetryDelay.
+    RetryPolicy      *RetryPolicy
+    RateLimit        rate.Limit
+    Burst            int
+    Logger           Logger
+    SensitiveHeaders []string
+    Debug            bool
 }
 
 type ApiResponse struct {
@@ -39,11 +51,17 @@ func (e *ApiError) Error() string {
 }
 
 type ApiClient struct {
-    config     ApiClientConfig
-    httpClient *http.Client
+    config      ApiClientConfig
+    httpClient  *http.Client
+    limiter     *rate.Limiter
+    retryPolicy RetryPolicy
+    logger      Logger
+    sensitive   map[string]struct{}
+    userAgent   string
 }
 
-func NewApiClient(config ApiClientConfig) *ApiClient {
+// NewApiClient builds an ApiClient from config, applying any opts on top.
+func NewApiClient(config ApiClientConfig, opts ...Option) *ApiClient {
     if config.Timeout == 0 {
         config.Timeout = 30 * time.Second
     }
@@ -54,131 +72,131 @@ func NewApiClient(config ApiClientConfig) *ApiClient {
         config.RetryDelay = time.Second
     }
 
-    return &ApiClient{
-        config: config,
-        httpClient: &http.Client{
-            Timeout: config.Timeout,
-        },
+    // An explicitly supplied RetryPolicy (even RetryPolicy{MaxRetries: 0})
+    // replaces the Retries/RetryDelay-based policy outright; only a nil
+    // RetryPolicy falls back to building one from Retries.
+    var retryPolicy RetryPolicy
+    if config.RetryPolicy != nil {
+        retryPolicy = *config.RetryPolicy
+    } else {
+        retryPolicy.MaxRetries = config.Retries
     }
-}
-
-func (c *ApiClient) makeRequest(method, endpoint string, body interface{}) (*ApiResponse, error) {
-    fullURL, err := url.JoinPath(c.config.BaseURL, endpoint)
-    if err != nil {
-        return nil, fmt.Errorf("invalid URL: %w", err)
+    if retryPolicy.MinRetryDelay == 0 {
+        retryPolicy.MinRetryDelay = config.RetryDelay
+    }
+    if retryPolicy.MaxRetryDelay == 0 {
+        retryPolicy.MaxRetryDelay = 30 * time.Second
+    }
+    if retryPolicy.BackoffFactor == 0 {
+        retryPolicy.BackoffFactor = 2
     }
 
-    var requestBody io.Reader
-    if body != nil {
-        jsonBody, err := json.Marshal(body)
-        if err != nil {
-            return nil, fmt.Errorf("failed to marshal request body: %w", err)
+    var limiter *rate.Limiter
+    if config.RateLimit > 0 {
+        burst := config.Burst
+        if burst == 0 {
+            burst = 1
         }
-        requestBody = bytes.NewReader(jsonBody)
+        limiter = rate.NewLimiter(config.RateLimit, burst)
     }
 
-    var lastErr error
-    for attempt := 0; attempt <= c.config.Retries; attempt++ {
-        req, err := http.NewRequest(method, fullURL, requestBody)
-        if err != nil {
-            return nil, fmt.Errorf("failed to create request: %w", err)
-        }
+    logger := config.Logger
+    if logger == nil && config.Debug {
+        logger = NewWriterLogger(os.Stdout)
+    }
 
-        req.Header.Set("Content-Type", "application/json")
-        if c.config.ApiKey != "" {
-            req.Header.Set("Authorization", "Bearer "+c.config.ApiKey)
-        }
+    client := &ApiClient{
+        config: config,
+        httpClient: &http.Client{
+            Timeout: config.Timeout,
+        },
+        limiter:     limiter,
+        retryPolicy: retryPolicy,
+        logger:      logger,
+        sensitive:   sensitiveHeaderSet(config.SensitiveHeaders),
+    }
 
-        if c.config.Debug {
-            fmt.Printf("[API Client] %s %s\n", method, fullURL)
-        }
+    for _, opt := range opts {
+        opt(client)
+    }
 
-        resp, err := c.httpClient.Do(req)
-        if err != nil {
-            lastErr = err
-            if attempt < c.config.Retries {
-                time.Sleep(c.config.RetryDelay * time.Duration(attempt+1))
-                continue
-            }
-            break
-        }
-        defer resp.Body.Close()
+    return client
+}
 
-        responseBody, err := io.ReadAll(resp.Body)
+// makeRequest shares ApiClient's retry/auth/rate-limit/logging pipeline via
+// execute, decoding a successful body by reading it fully and unmarshaling
+// into interface{} -- kept for ApiResponse back-compat. Prefer the generic
+// Get/Post/Put/Patch/Delete in typed.go, which decode straight into a
+// caller-supplied type instead.
+func (c *ApiClient) makeRequest(ctx context.Context, method, endpoint string, body interface{}) (*ApiResponse, error) {
+    resp, err := execute[interface{}](ctx, c, method, endpoint, body, func(httpResp *http.Response) (interface{}, []byte, error) {
+        responseBody, err := io.ReadAll(httpResp.Body)
         if err != nil {
-            return nil, fmt.Errorf("failed to read response body: %w", err)
-        }
-
-        headers := make(map[string]string)
-        for key, values := range resp.Header {
-            if len(values) > 0 {
-                headers[key] = values[0]
-            }
-        }
-
-        if resp.StatusCode >= 400 {
-            var errorData map[string]interface{}
-            json.Unmarshal(responseBody, &errorData)
-            
-            message := "Request failed"
-            code := "UNKNOWN_ERROR"
-            
-            if msg, ok := errorData["message"].(string); ok {
-                message = msg
-            }
-            if c, ok := errorData["code"].(string); ok {
-                code = c
-            }
-
-            apiErr := &ApiError{
-                Message: message,
-                Status:  resp.StatusCode,
-                Code:    code,
-                Details: errorData,
-            }
-
-            if resp.StatusCode >= 500 && attempt < c.config.Retries {
-                time.Sleep(c.config.RetryDelay * time.Duration(attempt+1))
-                continue
-            }
-
-            return nil, apiErr
+            return nil, nil, fmt.Errorf("failed to read response body: %w", err)
         }
 
         var data interface{}
         if len(responseBody) > 0 {
             json.Unmarshal(responseBody, &data)
         }
-
-        return &ApiResponse{
-            Data:    data,
-            Status:  resp.StatusCode,
-            Headers: headers,
-            Success: resp.StatusCode >= 200 && resp.StatusCode < 300,
-        }, nil
+        return data, responseBody, nil
+    })
+    if err != nil {
+        return nil, err
     }
 
-    return nil, fmt.Errorf("request failed after %d attempts: %w", c.config.Retries+1, lastErr)
+    return &ApiResponse{
+        Data:    resp.Data,
+        Status:  resp.Status,
+        Headers: resp.Headers,
+        Success: resp.Success,
+    }, nil
+}
+
+func (c *ApiClient) GetWithContext(ctx context.Context, endpoint string) (*ApiResponse, error) {
+    return c.makeRequest(ctx, "GET", endpoint, nil)
+}
+
+func (c *ApiClient) PostWithContext(ctx context.Context, endpoint string, body interface{}) (*ApiResponse, error) {
+    return c.makeRequest(ctx, "POST", endpoint, body)
+}
+
+func (c *ApiClient) PutWithContext(ctx context.Context, endpoint string, body interface{}) (*ApiResponse, error) {
+    return c.makeRequest(ctx, "PUT", endpoint, body)
+}
+
+func (c *ApiClient) PatchWithContext(ctx context.Context, endpoint string, body interface{}) (*ApiResponse, error) {
+    return c.makeRequest(ctx, "PATCH", endpoint, body)
+}
+
+func (c *ApiClient) DeleteWithContext(ctx context.Context, endpoint string) (*ApiResponse, error) {
+    return c.makeRequest(ctx, "DELETE", endpoint, nil)
 }
 
+// Get is a back-compat wrapper around GetWithContext using context.Background().
+// Prefer GetWithContext when cancellation or deadlines matter.
 func (c *ApiClient) Get(endpoint string) (*ApiResponse, error) {
-    return c.makeRequest("GET", endpoint, nil)
+    return c.GetWithContext(context.Background(), endpoint)
 }
 
+// Post is a back-compat wrapper around PostWithContext using context.Background().
 func (c *ApiClient) Post(endpoint string, body interface{}) (*ApiResponse, error) {
-    return c.makeRequest("POST", endpoint, body)
+    return c.PostWithContext(context.Background(), endpoint, body)
 }
 
+// Put is a back-compat wrapper around PutWithContext using context.Background().
 func (c *ApiClient) Put(endpoint string, body interface{}) (*ApiResponse, error) {
-    return c.makeRequest("PUT", endpoint, body)
+    return c.PutWithContext(context.Background(), endpoint, body)
 }
 
+// Patch is a back-compat wrapper around PatchWithContext using context.Background().
 func (c *ApiClient) Patch(endpoint string, body interface{}) (*ApiResponse, error) {
-    return c.makeRequest("PATCH", endpoint, body)
+    return c.PatchWithContext(context.Background(), endpoint, body)
 }
 
+// Delete is a back-compat wrapper around DeleteWithContext using context.Background().
 func (c *ApiClient) Delete(endpoint string) (*ApiResponse, error) {
-    return c.makeRequest("DELETE", endpoint, nil)
+    return c.DeleteWithContext(context.Background(), endpoint)
 }
 
 func (c *ApiClient) SetApiKey(apiKey string) {
@@ -0,0 +1,55 @@
+// packages/go/courses.go
+package nestjs_sdk
+
+import "context"
+
+// Course is the NestJS backend's Courses module representation of a course.
+type Course struct {
+    ID           string `json:"id"`
+    Title        string `json:"title"`
+    Description  string `json:"description"`
+    InstructorID string `json:"instructorId"`
+}
+
+// CreateCourseRequest is the payload accepted by CoursesResource.Create.
+type CreateCourseRequest struct {
+    Title        string `json:"title"`
+    Description  string `json:"description"`
+    InstructorID string `json:"instructorId"`
+}
+
+// CoursesResource is the sub-client for the NestJS backend's Courses module.
+type CoursesResource struct {
+    client *ApiClient
+}
+
+// NewCoursesResource builds a CoursesResource bound to client.
+func NewCoursesResource(client *ApiClient) *CoursesResource {
+    return &CoursesResource{client: client}
+}
+
+// List returns one page of courses matching opts.
+func (r *CoursesResource) List(ctx context.Context, opts ListOpts) (*Response[[]Course], error) {
+    return getList[Course](ctx, r.client, withListOpts("/courses", opts))
+}
+
+// ListAll streams every page of courses matching opts.
+func (r *CoursesResource) ListAll(ctx context.Context, opts ListOptions) <-chan Page[Course] {
+    paginator := NewPaginator[Course](r.client, "/courses", PageNumberStrategy[Course]{ItemsKey: "data"})
+    return paginator.Stream(ctx, opts)
+}
+
+// Get fetches a single course by ID.
+func (r *CoursesResource) Get(ctx context.Context, id string) (*Response[Course], error) {
+    return Get[Course](ctx, r.client, "/courses/"+id)
+}
+
+// Create creates a new course.
+func (r *CoursesResource) Create(ctx context.Context, req CreateCourseRequest) (*Response[Course], error) {
+    return Post[Course](ctx, r.client, "/courses", req)
+}
+
+// Delete removes a course.
+func (r *CoursesResource) Delete(ctx context.Context, id string) (*Response[struct{}], error) {
+    return Delete[struct{}](ctx, r.client, "/courses/"+id)
+}
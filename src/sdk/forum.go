@@ -0,0 +1,54 @@
+// packages/go/forum.go
+package nestjs_sdk
+
+import "context"
+
+// ForumPost is the NestJS backend's Forum module representation of a post.
+type ForumPost struct {
+    ID       string `json:"id"`
+    AuthorID string `json:"authorId"`
+    Title    string `json:"title"`
+    Body     string `json:"body"`
+}
+
+// CreateForumPostRequest is the payload accepted by ForumResource.Create.
+type CreateForumPostRequest struct {
+    Title string `json:"title"`
+    Body  string `json:"body"`
+}
+
+// ForumResource is the sub-client for the NestJS backend's Forum module.
+type ForumResource struct {
+    client *ApiClient
+}
+
+// NewForumResource builds a ForumResource bound to client.
+func NewForumResource(client *ApiClient) *ForumResource {
+    return &ForumResource{client: client}
+}
+
+// List returns one page of forum posts matching opts.
+func (r *ForumResource) List(ctx context.Context, opts ListOpts) (*Response[[]ForumPost], error) {
+    return getList[ForumPost](ctx, r.client, withListOpts("/forum/posts", opts))
+}
+
+// ListAll streams every page of forum posts matching opts.
+func (r *ForumResource) ListAll(ctx context.Context, opts ListOptions) <-chan Page[ForumPost] {
+    paginator := NewPaginator[ForumPost](r.client, "/forum/posts", PageNumberStrategy[ForumPost]{ItemsKey: "data"})
+    return paginator.Stream(ctx, opts)
+}
+
+// Get fetches a single forum post by ID.
+func (r *ForumResource) Get(ctx context.Context, id string) (*Response[ForumPost], error) {
+    return Get[ForumPost](ctx, r.client, "/forum/posts/"+id)
+}
+
+// Create creates a new forum post.
+func (r *ForumResource) Create(ctx context.Context, req CreateForumPostRequest) (*Response[ForumPost], error) {
+    return Post[ForumPost](ctx, r.client, "/forum/posts", req)
+}
+
+// Delete removes a forum post.
+func (r *ForumResource) Delete(ctx context.Context, id string) (*Response[struct{}], error) {
+    return Delete[struct{}](ctx, r.client, "/forum/posts/"+id)
+}
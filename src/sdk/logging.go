@@ -0,0 +1,139 @@
+// packages/go/logging.go
+package nestjs_sdk
+
+import (
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+    "strings"
+    "time"
+)
+
+// Logger receives structured request/response events from ApiClient.
+// NewWriterLogger provides the default implementation; the slogadapter and
+// logrusadapter subpackages adapt it onto log/slog and logrus without
+// pulling either dependency into the core SDK.
+type Logger interface {
+    Debugf(format string, args ...interface{})
+    Infof(format string, args ...interface{})
+    Warnf(format string, args ...interface{})
+    Errorf(format string, args ...interface{})
+}
+
+// RequestLog describes an outgoing request. Headers have already had
+// sensitive values redacted by the time ApiClient logs it.
+type RequestLog struct {
+    Method  string
+    URL     string
+    Headers http.Header
+    Body    string
+}
+
+// ResponseLog describes a completed response. Headers have already had
+// sensitive values redacted by the time ApiClient logs it.
+type ResponseLog struct {
+    Status   int
+    Headers  http.Header
+    Body     string
+    Duration time.Duration
+}
+
+func (r RequestLog) String() string {
+    return fmt.Sprintf("%s %s headers=%v body=%s", r.Method, r.URL, r.Headers, r.Body)
+}
+
+func (r ResponseLog) String() string {
+    return fmt.Sprintf("status=%d duration=%s headers=%v body=%s", r.Status, r.Duration, r.Headers, r.Body)
+}
+
+// defaultSensitiveHeaders are always redacted, in addition to any headers an
+// ApiClientConfig registers via SensitiveHeaders.
+var defaultSensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// maxLoggedBodyBytes caps how much of a request/response body is logged.
+const maxLoggedBodyBytes = 2048
+
+// sensitiveHeaderSet builds a lowercased lookup set of header names to
+// redact from extra, always including defaultSensitiveHeaders.
+func sensitiveHeaderSet(extra []string) map[string]struct{} {
+    set := make(map[string]struct{}, len(defaultSensitiveHeaders)+len(extra))
+    for _, h := range defaultSensitiveHeaders {
+        set[strings.ToLower(h)] = struct{}{}
+    }
+    for _, h := range extra {
+        set[strings.ToLower(h)] = struct{}{}
+    }
+    return set
+}
+
+// redactHeaders returns a copy of headers with any header named in sensitive
+// (matched case-insensitively) replaced by "[REDACTED]".
+func redactHeaders(headers http.Header, sensitive map[string]struct{}) http.Header {
+    out := make(http.Header, len(headers))
+    for key, values := range headers {
+        if _, ok := sensitive[strings.ToLower(key)]; ok {
+            out[key] = []string{"[REDACTED]"}
+            continue
+        }
+        out[key] = values
+    }
+    return out
+}
+
+// truncateBody caps body at maxLoggedBodyBytes so large payloads don't flood
+// logs.
+func truncateBody(body []byte) string {
+    if len(body) <= maxLoggedBodyBytes {
+        return string(body)
+    }
+    return fmt.Sprintf("%s... (truncated, %d bytes total)", body[:maxLoggedBodyBytes], len(body))
+}
+
+// LogLevel is the minimum severity a WriterLogger will emit.
+type LogLevel int
+
+const (
+    LevelDebug LogLevel = iota
+    LevelInfo
+    LevelWarn
+    LevelError
+)
+
+// WriterLogger is the default Logger: it writes each event as one line to W.
+type WriterLogger struct {
+    W     io.Writer
+    Level LogLevel
+}
+
+// NewWriterLogger returns a Logger that writes Debug-and-above events to w.
+func NewWriterLogger(w io.Writer) *WriterLogger {
+    return &WriterLogger{W: w, Level: LevelDebug}
+}
+
+func (l *WriterLogger) log(level LogLevel, prefix, format string, args ...interface{}) {
+    if level < l.Level {
+        return
+    }
+    w := l.W
+    if w == nil {
+        w = os.Stderr
+    }
+    fmt.Fprintf(w, "[%s] %s\n", prefix, fmt.Sprintf(format, args...))
+}
+
+func (l *WriterLogger) Debugf(format string, args ...interface{}) {
+    l.log(LevelDebug, "DEBUG", format, args...)
+}
+
+func (l *WriterLogger) Infof(format string, args ...interface{}) {
+    l.log(LevelInfo, "INFO", format, args...)
+}
+
+func (l *WriterLogger) Warnf(format string, args ...interface{}) {
+    l.log(LevelWarn, "WARN", format, args...)
+}
+
+func (l *WriterLogger) Errorf(format string, args ...interface{}) {
+    l.log(LevelError, "ERROR", format, args...)
+}
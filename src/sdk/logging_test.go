@@ -0,0 +1,114 @@
+// packages/go/logging_test.go
+package nestjs_sdk
+
+import (
+    "fmt"
+    "net/http"
+    "strings"
+    "testing"
+)
+
+func TestSensitiveHeaderSetIsCaseInsensitive(t *testing.T) {
+    set := sensitiveHeaderSet([]string{"X-Api-Key"})
+
+    for _, h := range []string{"authorization", "Authorization", "AUTHORIZATION", "cookie", "Set-Cookie", "x-api-key", "X-API-KEY"} {
+        if _, ok := set[strings.ToLower(h)]; !ok {
+            t.Errorf("sensitiveHeaderSet missing entry for %q", h)
+        }
+    }
+
+    if _, ok := set[strings.ToLower("Content-Type")]; ok {
+        t.Error("sensitiveHeaderSet should not treat Content-Type as sensitive")
+    }
+}
+
+func TestRedactHeadersRedactsDefaultAndRegisteredHeaders(t *testing.T) {
+    sensitive := sensitiveHeaderSet([]string{"X-Api-Key"})
+
+    headers := http.Header{}
+    headers.Set("Authorization", "Bearer secret-token")
+    headers.Set("Cookie", "session=abc123")
+    headers.Set("X-Api-Key", "super-secret")
+    headers.Set("Content-Type", "application/json")
+
+    redacted := redactHeaders(headers, sensitive)
+
+    for _, h := range []string{"Authorization", "Cookie", "X-Api-Key"} {
+        if got := redacted.Get(h); got != "[REDACTED]" {
+            t.Errorf("redactHeaders: header %q = %q, want [REDACTED]", h, got)
+        }
+    }
+    if got := redacted.Get("Content-Type"); got != "application/json" {
+        t.Errorf("redactHeaders: Content-Type = %q, want unchanged", got)
+    }
+}
+
+func TestRedactHeadersMatchesCaseInsensitively(t *testing.T) {
+    sensitive := sensitiveHeaderSet(nil)
+
+    headers := http.Header{}
+    // Set directly rather than via Header.Set/Add, which canonicalizes the
+    // key, so this also exercises a header name that differs in case from
+    // defaultSensitiveHeaders' canonical form.
+    headers["authorization"] = []string{"Bearer secret-token"}
+
+    redacted := redactHeaders(headers, sensitive)
+
+    // Index the map directly instead of Get, which canonicalizes the
+    // lookup key itself and would mask a redactHeaders bug here.
+    got := redacted["authorization"]
+    if len(got) != 1 || got[0] != "[REDACTED]" {
+        t.Errorf("redactHeaders: lowercase authorization = %v, want [REDACTED]", got)
+    }
+}
+
+func TestRedactHeadersDoesNotMutateInput(t *testing.T) {
+    sensitive := sensitiveHeaderSet(nil)
+
+    headers := http.Header{}
+    headers.Set("Authorization", "Bearer secret-token")
+
+    redactHeaders(headers, sensitive)
+
+    if got := headers.Get("Authorization"); got != "Bearer secret-token" {
+        t.Errorf("redactHeaders mutated its input: Authorization = %q", got)
+    }
+}
+
+func TestTruncateBodyUnderLimit(t *testing.T) {
+    body := []byte("short body")
+    if got := truncateBody(body); got != string(body) {
+        t.Errorf("truncateBody(%d bytes) = %q, want unchanged", len(body), got)
+    }
+}
+
+func TestTruncateBodyAtLimit(t *testing.T) {
+    body := make([]byte, maxLoggedBodyBytes)
+    for i := range body {
+        body[i] = 'a'
+    }
+    if got := truncateBody(body); got != string(body) {
+        t.Errorf("truncateBody(%d bytes, == limit) should not be truncated", len(body))
+    }
+}
+
+func TestTruncateBodyOverLimit(t *testing.T) {
+    const extra = 500
+    body := make([]byte, maxLoggedBodyBytes+extra)
+    for i := range body {
+        body[i] = 'a'
+    }
+
+    got := truncateBody(body)
+
+    if strings.Contains(got, strings.Repeat("a", maxLoggedBodyBytes+1)) {
+        t.Error("truncateBody did not truncate the body at maxLoggedBodyBytes")
+    }
+    if !strings.Contains(got, "truncated") {
+        t.Errorf("truncateBody(%d bytes) = %q, want a truncation marker", len(body), got)
+    }
+    wantTotal := fmt.Sprintf("%d bytes total", len(body))
+    if !strings.Contains(got, wantTotal) {
+        t.Errorf("truncateBody(%d bytes) = %q, want it to contain %q", len(body), got, wantTotal)
+    }
+}
@@ -0,0 +1,29 @@
+// packages/go/logrusadapter/logrus.go
+// Package logrusadapter adapts *logrus.Logger to nestjs_sdk.Logger. It is a
+// separate package so importing the core SDK never pulls in logrus; only
+// callers that want logrus output need this package.
+package logrusadapter
+
+import (
+    "github.com/sirupsen/logrus"
+
+    nestjs "your-org/nestjs-api-sdk"
+)
+
+// Logger adapts a *logrus.Logger (or logrus.Entry via logrus.NewEntry) to
+// the nestjs_sdk.Logger interface.
+type Logger struct {
+    L *logrus.Logger
+}
+
+// New wraps l as a nestjs_sdk.Logger.
+func New(l *logrus.Logger) *Logger {
+    return &Logger{L: l}
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) { l.L.Debugf(format, args...) }
+func (l *Logger) Infof(format string, args ...interface{})  { l.L.Infof(format, args...) }
+func (l *Logger) Warnf(format string, args ...interface{})  { l.L.Warnf(format, args...) }
+func (l *Logger) Errorf(format string, args ...interface{}) { l.L.Errorf(format, args...) }
+
+var _ nestjs.Logger = (*Logger)(nil)
@@ -0,0 +1,46 @@
+// packages/go/notifications.go
+package nestjs_sdk
+
+import "context"
+
+// Notification is the NestJS backend's Notifications module representation
+// of a user notification.
+type Notification struct {
+    ID     string `json:"id"`
+    UserID string `json:"userId"`
+    Title  string `json:"title"`
+    Body   string `json:"body"`
+    Read   bool   `json:"read"`
+}
+
+// NotificationsResource is the sub-client for the NestJS backend's
+// Notifications module.
+type NotificationsResource struct {
+    client *ApiClient
+}
+
+// NewNotificationsResource builds a NotificationsResource bound to client.
+func NewNotificationsResource(client *ApiClient) *NotificationsResource {
+    return &NotificationsResource{client: client}
+}
+
+// List returns one page of notifications matching opts.
+func (r *NotificationsResource) List(ctx context.Context, opts ListOpts) (*Response[[]Notification], error) {
+    return getList[Notification](ctx, r.client, withListOpts("/notifications", opts))
+}
+
+// ListAll streams every page of notifications matching opts.
+func (r *NotificationsResource) ListAll(ctx context.Context, opts ListOptions) <-chan Page[Notification] {
+    paginator := NewPaginator[Notification](r.client, "/notifications", PageNumberStrategy[Notification]{ItemsKey: "data"})
+    return paginator.Stream(ctx, opts)
+}
+
+// MarkRead marks a notification as read.
+func (r *NotificationsResource) MarkRead(ctx context.Context, id string) (*Response[Notification], error) {
+    return Patch[Notification](ctx, r.client, "/notifications/"+id, map[string]bool{"read": true})
+}
+
+// Delete removes a notification.
+func (r *NotificationsResource) Delete(ctx context.Context, id string) (*Response[struct{}], error) {
+    return Delete[struct{}](ctx, r.client, "/notifications/"+id)
+}
@@ -0,0 +1,50 @@
+// packages/go/options.go
+package nestjs_sdk
+
+import (
+    "net/http"
+
+    "golang.org/x/time/rate"
+)
+
+// Option configures an ApiClient at construction time. Options are applied
+// after ApiClientConfig's own defaults, so they take precedence over it --
+// useful for settings more naturally expressed as a value than a config
+// field, like an already-built *http.Client.
+type Option func(*ApiClient)
+
+// WithBaseURL overrides ApiClientConfig.BaseURL.
+func WithBaseURL(baseURL string) Option {
+    return func(c *ApiClient) { c.config.BaseURL = baseURL }
+}
+
+// WithHTTPClient replaces the client's underlying *http.Client, e.g. to
+// share a transport or inject instrumentation.
+func WithHTTPClient(httpClient *http.Client) Option {
+    return func(c *ApiClient) { c.httpClient = httpClient }
+}
+
+// WithLogger overrides ApiClientConfig.Logger.
+func WithLogger(logger Logger) Option {
+    return func(c *ApiClient) { c.logger = logger }
+}
+
+// WithRateLimit overrides ApiClientConfig.RateLimit/Burst.
+func WithRateLimit(limit rate.Limit, burst int) Option {
+    return func(c *ApiClient) {
+        if burst == 0 {
+            burst = 1
+        }
+        c.limiter = rate.NewLimiter(limit, burst)
+    }
+}
+
+// WithRetryPolicy overrides ApiClientConfig.RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+    return func(c *ApiClient) { c.retryPolicy = policy }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+    return func(c *ApiClient) { c.userAgent = userAgent }
+}
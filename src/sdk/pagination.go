@@ -0,0 +1,221 @@
+// packages/go/pagination.go
+package nestjs_sdk
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/url"
+    "strconv"
+)
+
+// Page is one page of paginated results of type T. Err is set (and Items,
+// HasMore left zero) when fetching or decoding the page failed; it is always
+// the last value sent on a Paginator's channel.
+type Page[T any] struct {
+    Items   []T
+    HasMore bool
+    Err     error
+}
+
+// ListOptions configures a paginated List call. Which fields apply depends
+// on the PaginationStrategy in use: Page for page-number pagination, Cursor
+// for cursor-based pagination. Limit applies to both.
+type ListOptions struct {
+    Limit  int
+    Page   int
+    Cursor string
+}
+
+// PaginationStrategy interprets one page's decoded response body: it
+// extracts the page's items and decides the ListOptions for the next page,
+// if any. Implementations are provided for page-number pagination
+// (PageNumberStrategy) and cursor pagination (CursorStrategy); offset/limit
+// endpoints can reuse PageNumberStrategy by treating Page as an offset.
+type PaginationStrategy[T any] interface {
+    Items(raw interface{}) ([]T, error)
+    Next(raw interface{}, resp *ApiResponse, current ListOptions) (ListOptions, bool)
+}
+
+// Paginator streams pages from a paginated NestJS list endpoint using a
+// PaginationStrategy to interpret each response.
+type Paginator[T any] struct {
+    client   *ApiClient
+    endpoint string
+    strategy PaginationStrategy[T]
+}
+
+// NewPaginator builds a Paginator that lists endpoint on client, paginating
+// according to strategy.
+func NewPaginator[T any](client *ApiClient, endpoint string, strategy PaginationStrategy[T]) *Paginator[T] {
+    return &Paginator[T]{client: client, endpoint: endpoint, strategy: strategy}
+}
+
+// Stream fetches pages starting from opts, sending each onto the returned
+// channel as it arrives. The channel closes after the last page, after an
+// error, or when ctx is canceled.
+func (p *Paginator[T]) Stream(ctx context.Context, opts ListOptions) <-chan Page[T] {
+    out := make(chan Page[T])
+
+    go func() {
+        defer close(out)
+
+        current := opts
+        for {
+            endpoint, err := appendListOptions(p.endpoint, current)
+            if err != nil {
+                sendPage(ctx, out, Page[T]{Err: err})
+                return
+            }
+
+            resp, err := p.client.GetWithContext(ctx, endpoint)
+            if err != nil {
+                sendPage(ctx, out, Page[T]{Err: err})
+                return
+            }
+
+            items, err := p.strategy.Items(resp.Data)
+            if err != nil {
+                sendPage(ctx, out, Page[T]{Err: err})
+                return
+            }
+
+            next, hasMore := p.strategy.Next(resp.Data, resp, current)
+            if !sendPage(ctx, out, Page[T]{Items: items, HasMore: hasMore}) {
+                return
+            }
+            if !hasMore {
+                return
+            }
+            current = next
+        }
+    }()
+
+    return out
+}
+
+// sendPage sends page on out, reporting false if ctx was canceled first.
+func sendPage[T any](ctx context.Context, out chan<- Page[T], page Page[T]) bool {
+    select {
+    case out <- page:
+        return true
+    case <-ctx.Done():
+        return false
+    }
+}
+
+// appendListOptions appends the non-zero fields of opts to endpoint as query
+// parameters.
+func appendListOptions(endpoint string, opts ListOptions) (string, error) {
+    u, err := url.Parse(endpoint)
+    if err != nil {
+        return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+    }
+
+    q := u.Query()
+    if opts.Limit > 0 {
+        q.Set("limit", strconv.Itoa(opts.Limit))
+    }
+    if opts.Page > 0 {
+        q.Set("page", strconv.Itoa(opts.Page))
+    }
+    if opts.Cursor != "" {
+        q.Set("cursor", opts.Cursor)
+    }
+    u.RawQuery = q.Encode()
+    return u.String(), nil
+}
+
+// PageNumberStrategy paginates by incrementing ListOptions.Page until a page
+// returns fewer items than Limit (or zero items). ItemsKey names the field
+// holding the item array in the response body, e.g. "data"; leave it empty
+// if the body itself is the array.
+type PageNumberStrategy[T any] struct {
+    ItemsKey string
+}
+
+func (s PageNumberStrategy[T]) Items(raw interface{}) ([]T, error) {
+    return extractItems[T](raw, s.ItemsKey)
+}
+
+func (s PageNumberStrategy[T]) Next(raw interface{}, resp *ApiResponse, current ListOptions) (ListOptions, bool) {
+    items, err := s.Items(raw)
+    if err != nil || len(items) == 0 {
+        return ListOptions{}, false
+    }
+    if current.Limit > 0 && len(items) < current.Limit {
+        return ListOptions{}, false
+    }
+
+    next := current
+    if next.Page == 0 {
+        next.Page = 1
+    }
+    next.Page++
+    return next, true
+}
+
+// CursorStrategy paginates using a cursor field nested in the response
+// body, e.g. {"data": [...], "next_cursor": "..."}. CursorKey defaults to
+// "next_cursor"; an absent or empty cursor ends pagination.
+type CursorStrategy[T any] struct {
+    ItemsKey  string
+    CursorKey string
+}
+
+func (s CursorStrategy[T]) Items(raw interface{}) ([]T, error) {
+    return extractItems[T](raw, s.ItemsKey)
+}
+
+func (s CursorStrategy[T]) Next(raw interface{}, resp *ApiResponse, current ListOptions) (ListOptions, bool) {
+    cursorKey := s.CursorKey
+    if cursorKey == "" {
+        cursorKey = "next_cursor"
+    }
+
+    m, ok := raw.(map[string]interface{})
+    if !ok {
+        return ListOptions{}, false
+    }
+
+    cursor, ok := m[cursorKey].(string)
+    if !ok || cursor == "" {
+        return ListOptions{}, false
+    }
+
+    next := current
+    next.Cursor = cursor
+    return next, true
+}
+
+// extractItems decodes the page of items out of raw: either raw itself (if
+// key is empty) or raw[key], both expected to be a JSON array.
+func extractItems[T any](raw interface{}, key string) ([]T, error) {
+    if key != "" {
+        m, ok := raw.(map[string]interface{})
+        if !ok {
+            return nil, fmt.Errorf("expected object response body, got %T", raw)
+        }
+        raw = m[key]
+    }
+
+    list, ok := raw.([]interface{})
+    if !ok {
+        return nil, fmt.Errorf("expected array of items, got %T", raw)
+    }
+
+    items := make([]T, 0, len(list))
+    for _, entry := range list {
+        b, err := json.Marshal(entry)
+        if err != nil {
+            return nil, fmt.Errorf("failed to re-marshal item: %w", err)
+        }
+
+        var item T
+        if err := json.Unmarshal(b, &item); err != nil {
+            return nil, fmt.Errorf("failed to decode item: %w", err)
+        }
+        items = append(items, item)
+    }
+    return items, nil
+}
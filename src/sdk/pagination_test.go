@@ -0,0 +1,145 @@
+// packages/go/pagination_test.go
+package nestjs_sdk
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+)
+
+type paginationTestItem struct {
+    ID int `json:"id"`
+}
+
+func collectPages[T any](ch <-chan Page[T]) []Page[T] {
+    var pages []Page[T]
+    for page := range ch {
+        pages = append(pages, page)
+    }
+    return pages
+}
+
+func TestPaginatorPageNumberStrategyStreamsAllPages(t *testing.T) {
+    const limit = 2
+
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        page := r.URL.Query().Get("page")
+
+        var items []paginationTestItem
+        switch page {
+        case "", "1":
+            items = []paginationTestItem{{ID: 1}, {ID: 2}}
+        case "2":
+            items = []paginationTestItem{{ID: 3}, {ID: 4}}
+        case "3":
+            items = []paginationTestItem{{ID: 5}}
+        default:
+            t.Fatalf("unexpected page %q", page)
+        }
+
+        json.NewEncoder(w).Encode(map[string]interface{}{"data": items})
+    }))
+    defer server.Close()
+
+    client := NewApiClient(ApiClientConfig{BaseURL: server.URL})
+    paginator := NewPaginator[paginationTestItem](client, "/items", PageNumberStrategy[paginationTestItem]{ItemsKey: "data"})
+
+    pages := collectPages(paginator.Stream(context.Background(), ListOptions{Limit: limit}))
+
+    if len(pages) != 3 {
+        t.Fatalf("got %d pages, want 3", len(pages))
+    }
+
+    var allIDs []int
+    for i, page := range pages {
+        if page.Err != nil {
+            t.Fatalf("page %d: unexpected error %v", i, page.Err)
+        }
+        for _, item := range page.Items {
+            allIDs = append(allIDs, item.ID)
+        }
+    }
+    if len(allIDs) != 5 {
+        t.Errorf("got %d total items across pages, want 5", len(allIDs))
+    }
+
+    if !pages[0].HasMore || !pages[1].HasMore {
+        t.Error("full pages (len == limit) should report HasMore = true")
+    }
+    if pages[2].HasMore {
+        t.Error("last, short page should report HasMore = false")
+    }
+}
+
+func TestPaginatorCursorStrategyStopsWhenCursorIsEmpty(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        cursor := r.URL.Query().Get("cursor")
+
+        switch cursor {
+        case "":
+            json.NewEncoder(w).Encode(map[string]interface{}{
+                "data":        []paginationTestItem{{ID: 1}},
+                "next_cursor": "page-2",
+            })
+        case "page-2":
+            json.NewEncoder(w).Encode(map[string]interface{}{
+                "data":        []paginationTestItem{{ID: 2}},
+                "next_cursor": "",
+            })
+        default:
+            t.Fatalf("unexpected cursor %q", cursor)
+        }
+    }))
+    defer server.Close()
+
+    client := NewApiClient(ApiClientConfig{BaseURL: server.URL})
+    paginator := NewPaginator[paginationTestItem](client, "/items", CursorStrategy[paginationTestItem]{ItemsKey: "data"})
+
+    pages := collectPages(paginator.Stream(context.Background(), ListOptions{}))
+
+    if len(pages) != 2 {
+        t.Fatalf("got %d pages, want 2", len(pages))
+    }
+    if !pages[0].HasMore {
+        t.Error("first page has a next_cursor, so HasMore should be true")
+    }
+    if pages[1].HasMore {
+        t.Error("second page's next_cursor is empty, so HasMore should be false")
+    }
+
+    var allIDs []int
+    for _, page := range pages {
+        for _, item := range page.Items {
+            allIDs = append(allIDs, item.ID)
+        }
+    }
+    if len(allIDs) != 2 {
+        t.Errorf("got %d total items, want 2", len(allIDs))
+    }
+}
+
+func TestPaginatorSurfacesErrorOnChannel(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        // Respond with an object where PageNumberStrategy (ItemsKey: "")
+        // expects a bare array -- Items should fail to extract the page.
+        json.NewEncoder(w).Encode(map[string]interface{}{"unexpected": "shape"})
+    }))
+    defer server.Close()
+
+    client := NewApiClient(ApiClientConfig{BaseURL: server.URL})
+    paginator := NewPaginator[paginationTestItem](client, "/items", PageNumberStrategy[paginationTestItem]{})
+
+    pages := collectPages(paginator.Stream(context.Background(), ListOptions{}))
+
+    if len(pages) != 1 {
+        t.Fatalf("got %d pages, want exactly 1 (the error page)", len(pages))
+    }
+    if pages[0].Err == nil {
+        t.Fatal("expected an error on the page, got nil")
+    }
+    if len(pages[0].Items) != 0 {
+        t.Errorf("error page should carry no items, got %d", len(pages[0].Items))
+    }
+}
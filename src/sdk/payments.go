@@ -0,0 +1,52 @@
+// packages/go/payments.go
+package nestjs_sdk
+
+import "context"
+
+// Payment is the NestJS backend's Payments module representation of a
+// transaction.
+type Payment struct {
+    ID       string `json:"id"`
+    UserID   string `json:"userId"`
+    Amount   int64  `json:"amount"`
+    Currency string `json:"currency"`
+    Status   string `json:"status"`
+}
+
+// CreatePaymentRequest is the payload accepted by PaymentsResource.Create.
+type CreatePaymentRequest struct {
+    UserID   string `json:"userId"`
+    Amount   int64  `json:"amount"`
+    Currency string `json:"currency"`
+}
+
+// PaymentsResource is the sub-client for the NestJS backend's Payments
+// module.
+type PaymentsResource struct {
+    client *ApiClient
+}
+
+// NewPaymentsResource builds a PaymentsResource bound to client.
+func NewPaymentsResource(client *ApiClient) *PaymentsResource {
+    return &PaymentsResource{client: client}
+}
+
+// List returns one page of payments matching opts.
+func (r *PaymentsResource) List(ctx context.Context, opts ListOpts) (*Response[[]Payment], error) {
+    return getList[Payment](ctx, r.client, withListOpts("/payments", opts))
+}
+
+// Get fetches a single payment by ID.
+func (r *PaymentsResource) Get(ctx context.Context, id string) (*Response[Payment], error) {
+    return Get[Payment](ctx, r.client, "/payments/"+id)
+}
+
+// Create initiates a new payment.
+func (r *PaymentsResource) Create(ctx context.Context, req CreatePaymentRequest) (*Response[Payment], error) {
+    return Post[Payment](ctx, r.client, "/payments", req)
+}
+
+// Refund refunds an existing payment.
+func (r *PaymentsResource) Refund(ctx context.Context, id string) (*Response[Payment], error) {
+    return Post[Payment](ctx, r.client, "/payments/"+id+"/refund", nil)
+}
@@ -0,0 +1,34 @@
+// packages/go/ratelimit.go
+package nestjs_sdk
+
+import (
+    "net/http"
+    "strconv"
+    "time"
+)
+
+// parseRetryAfter parses a Retry-After header in either of its two allowed
+// forms: an integer number of seconds, or an HTTP-date. It reports false if
+// the header is empty or unparsable.
+func parseRetryAfter(header string) (time.Duration, bool) {
+    if header == "" {
+        return 0, false
+    }
+
+    if seconds, err := strconv.Atoi(header); err == nil {
+        if seconds < 0 {
+            return 0, false
+        }
+        return time.Duration(seconds) * time.Second, true
+    }
+
+    if when, err := http.ParseTime(header); err == nil {
+        d := time.Until(when)
+        if d < 0 {
+            d = 0
+        }
+        return d, true
+    }
+
+    return 0, false
+}
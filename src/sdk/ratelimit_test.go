@@ -0,0 +1,63 @@
+// packages/go/ratelimit_test.go
+package nestjs_sdk
+
+import (
+    "net/http"
+    "testing"
+    "time"
+)
+
+func TestParseRetryAfterSeconds(t *testing.T) {
+    tests := []struct {
+        name   string
+        header string
+        want   time.Duration
+        wantOk bool
+    }{
+        {"empty header", "", 0, false},
+        {"zero seconds", "0", 0, true},
+        {"positive seconds", "120", 120 * time.Second, true},
+        {"negative seconds is invalid", "-5", 0, false},
+        {"not a number or date", "not-a-valid-header", 0, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, ok := parseRetryAfter(tt.header)
+            if ok != tt.wantOk {
+                t.Fatalf("parseRetryAfter(%q) ok = %v, want %v", tt.header, ok, tt.wantOk)
+            }
+            if ok && got != tt.want {
+                t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestParseRetryAfterHTTPDate(t *testing.T) {
+    future := time.Now().Add(2 * time.Minute).UTC()
+    header := future.Format(http.TimeFormat)
+
+    got, ok := parseRetryAfter(header)
+    if !ok {
+        t.Fatalf("parseRetryAfter(%q) ok = false, want true", header)
+    }
+
+    // Allow a few seconds of slack for the time elapsed between formatting
+    // the fixture and parsing it back out.
+    if got < 115*time.Second || got > 120*time.Second {
+        t.Errorf("parseRetryAfter(%q) = %v, want ~120s", header, got)
+    }
+}
+
+func TestParseRetryAfterPastHTTPDateClampsToZero(t *testing.T) {
+    past := time.Now().Add(-1 * time.Hour).UTC().Format(http.TimeFormat)
+
+    got, ok := parseRetryAfter(past)
+    if !ok {
+        t.Fatalf("parseRetryAfter(%q) ok = false, want true", past)
+    }
+    if got != 0 {
+        t.Errorf("parseRetryAfter(%q) = %v, want 0", past, got)
+    }
+}
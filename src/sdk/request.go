@@ -0,0 +1,233 @@
+// packages/go/request.go
+package nestjs_sdk
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "net/url"
+    "time"
+)
+
+// decodeFunc turns a successful (status < 400) *http.Response into a value
+// of type T. It also returns the bytes it consumed, for logging -- nil if it
+// decoded without buffering the whole body (e.g. a streaming json.Decoder).
+type decodeFunc[T any] func(resp *http.Response) (value T, loggedBody []byte, err error)
+
+// execute is ApiClient's single request/retry pipeline: it builds and signs
+// the request, applies rate limiting, retries according to c.retryPolicy
+// (including the one-shot OAuth2 401 refresh and Retry-After-aware 429
+// handling), logs each attempt, and hands a successful response to decode to
+// produce T. makeRequest and the generic Get/Post/Put/Patch/Delete are both
+// thin wrappers around it, differing only in how they decode a successful
+// body -- this is the one place retry/auth/logging behavior lives.
+func execute[T any](ctx context.Context, c *ApiClient, method, endpoint string, body interface{}, decode decodeFunc[T]) (*Response[T], error) {
+    fullURL, err := joinURL(c.config.BaseURL, endpoint)
+    if err != nil {
+        return nil, fmt.Errorf("invalid URL: %w", err)
+    }
+
+    var bodyBytes []byte
+    if body != nil {
+        jsonBody, err := json.Marshal(body)
+        if err != nil {
+            return nil, fmt.Errorf("failed to marshal request body: %w", err)
+        }
+        bodyBytes = jsonBody
+    }
+
+    var lastErr error
+    var authRetried bool
+    for attempt := 0; attempt <= c.retryPolicy.MaxRetries; attempt++ {
+        if err := ctx.Err(); err != nil {
+            return nil, err
+        }
+
+        // Rebuild the body reader on every attempt: an io.Reader is
+        // consumed after the first Do(), so reusing it across retries would
+        // send an empty body.
+        var requestBody io.Reader
+        if bodyBytes != nil {
+            requestBody = bytes.NewReader(bodyBytes)
+        }
+
+        req, err := http.NewRequestWithContext(ctx, method, fullURL, requestBody)
+        if err != nil {
+            return nil, fmt.Errorf("failed to create request: %w", err)
+        }
+
+        req.Header.Set("Content-Type", "application/json")
+        if c.config.Authenticator != nil {
+            if err := c.config.Authenticator.Apply(req); err != nil {
+                return nil, fmt.Errorf("failed to apply authenticator: %w", err)
+            }
+        } else if c.config.ApiKey != "" {
+            req.Header.Set("Authorization", "Bearer "+c.config.ApiKey)
+        }
+        if c.userAgent != "" {
+            req.Header.Set("User-Agent", c.userAgent)
+        }
+
+        if c.limiter != nil {
+            if err := c.limiter.Wait(ctx); err != nil {
+                return nil, fmt.Errorf("rate limiter: %w", err)
+            }
+        }
+
+        if c.logger != nil {
+            c.logger.Debugf("%s", RequestLog{
+                Method:  method,
+                URL:     fullURL,
+                Headers: redactHeaders(req.Header, c.sensitive),
+                Body:    truncateBody(bodyBytes),
+            })
+        }
+
+        attemptStart := time.Now()
+        resp, err := c.httpClient.Do(req)
+        if err != nil {
+            lastErr = err
+            if c.logger != nil {
+                c.logger.Errorf("%s %s failed: %v", method, fullURL, err)
+            }
+            if attempt < c.retryPolicy.MaxRetries && c.retryPolicy.retryable(method, nil, err) {
+                if err := sleepWithContext(ctx, c.retryPolicy.delay(attempt)); err != nil {
+                    return nil, err
+                }
+                continue
+            }
+            break
+        }
+
+        if resp.StatusCode >= 400 {
+            errBody, readErr := io.ReadAll(resp.Body)
+            resp.Body.Close()
+            if readErr != nil {
+                return nil, fmt.Errorf("failed to read response body: %w", readErr)
+            }
+
+            if c.logger != nil {
+                c.logger.Debugf("%s", ResponseLog{
+                    Status:   resp.StatusCode,
+                    Headers:  redactHeaders(resp.Header, c.sensitive),
+                    Body:     truncateBody(errBody),
+                    Duration: time.Since(attemptStart),
+                })
+            }
+
+            var errorData map[string]interface{}
+            json.Unmarshal(errBody, &errorData)
+
+            message := "Request failed"
+            code := "UNKNOWN_ERROR"
+            if msg, ok := errorData["message"].(string); ok {
+                message = msg
+            }
+            if cd, ok := errorData["code"].(string); ok {
+                code = cd
+            }
+
+            apiErr := &ApiError{
+                Message: message,
+                Status:  resp.StatusCode,
+                Code:    code,
+                Details: errorData,
+            }
+
+            if resp.StatusCode == http.StatusUnauthorized && !authRetried {
+                if oauth, ok := c.config.Authenticator.(*OAuth2Authenticator); ok {
+                    authRetried = true
+                    if refreshErr := oauth.refresh(ctx); refreshErr == nil {
+                        attempt--
+                        continue
+                    }
+                }
+            }
+
+            if attempt < c.retryPolicy.MaxRetries && c.retryPolicy.retryable(method, resp, nil) {
+                delay := c.retryPolicy.delay(attempt)
+                if resp.StatusCode == http.StatusTooManyRequests {
+                    if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+                        delay = retryAfter
+                    }
+                }
+                if err := sleepWithContext(ctx, delay); err != nil {
+                    return nil, err
+                }
+                continue
+            }
+
+            return nil, apiErr
+        }
+
+        defer resp.Body.Close()
+
+        data, loggedBody, err := decode(resp)
+        if err != nil {
+            return nil, err
+        }
+
+        if c.logger != nil {
+            c.logger.Debugf("%s", ResponseLog{
+                Status:   resp.StatusCode,
+                Headers:  redactHeaders(resp.Header, c.sensitive),
+                Body:     truncateBody(loggedBody),
+                Duration: time.Since(attemptStart),
+            })
+        }
+
+        headers := make(map[string]string, len(resp.Header))
+        for key, values := range resp.Header {
+            if len(values) > 0 {
+                headers[key] = values[0]
+            }
+        }
+
+        return &Response[T]{
+            Data:    data,
+            Status:  resp.StatusCode,
+            Headers: headers,
+            Success: resp.StatusCode >= 200 && resp.StatusCode < 300,
+        }, nil
+    }
+
+    return nil, fmt.Errorf("request failed after %d attempts: %w", c.retryPolicy.MaxRetries+1, lastErr)
+}
+
+// joinURL joins base and endpoint, preserving endpoint's query string.
+// url.JoinPath alone can't be used here: endpoint often already carries a
+// query string (e.g. from withListOpts/appendListOptions), and JoinPath
+// percent-escapes its arguments as literal path segments, turning
+// "?page=2" into the literal path segment "%3Fpage=2" instead of a query.
+func joinURL(base, endpoint string) (string, error) {
+    u, err := url.Parse(endpoint)
+    if err != nil {
+        return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+    }
+
+    full, err := url.JoinPath(base, u.EscapedPath())
+    if err != nil {
+        return "", err
+    }
+    if u.RawQuery != "" {
+        full += "?" + u.RawQuery
+    }
+    return full, nil
+}
+
+// sleepWithContext waits for d, returning early with ctx.Err() if ctx is
+// canceled or its deadline expires before d elapses.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+    timer := time.NewTimer(d)
+    defer timer.Stop()
+
+    select {
+    case <-ctx.Done():
+        return ctx.Err()
+    case <-timer.C:
+        return nil
+    }
+}
@@ -0,0 +1,77 @@
+// packages/go/resources.go
+package nestjs_sdk
+
+import (
+    "context"
+    "net/url"
+    "strconv"
+    "strings"
+)
+
+// ListOpts are the filtering, sorting, and pagination query parameters
+// shared by the SDK's resource List methods.
+type ListOpts struct {
+    Page    int
+    Limit   int
+    Sort    string
+    Order   string // "asc" or "desc"
+    Filters map[string]string
+}
+
+// Values serializes o into URL query parameters.
+func (o ListOpts) Values() url.Values {
+    q := url.Values{}
+    if o.Page > 0 {
+        q.Set("page", strconv.Itoa(o.Page))
+    }
+    if o.Limit > 0 {
+        q.Set("limit", strconv.Itoa(o.Limit))
+    }
+    if o.Sort != "" {
+        q.Set("sort", o.Sort)
+    }
+    if o.Order != "" {
+        q.Set("order", o.Order)
+    }
+    for k, v := range o.Filters {
+        q.Set(k, v)
+    }
+    return q
+}
+
+// withListOpts appends opts to endpoint as a query string.
+func withListOpts(endpoint string, opts ListOpts) string {
+    values := opts.Values()
+    if len(values) == 0 {
+        return endpoint
+    }
+
+    separator := "?"
+    if strings.Contains(endpoint, "?") {
+        separator = "&"
+    }
+    return endpoint + separator + values.Encode()
+}
+
+// listEnvelope is the NestJS backend's list-endpoint response shape,
+// {"data": [...]}, the same envelope PageNumberStrategy's ItemsKey: "data"
+// unwraps for ListAll.
+type listEnvelope[T any] struct {
+    Data []T `json:"data"`
+}
+
+// getList fetches one page of T from endpoint, unwrapping the backend's
+// {"data": [...]} list envelope so a resource's List and ListAll agree on
+// the response shape.
+func getList[T any](ctx context.Context, client *ApiClient, endpoint string) (*Response[[]T], error) {
+    resp, err := Get[listEnvelope[T]](ctx, client, endpoint)
+    if err != nil {
+        return nil, err
+    }
+    return &Response[[]T]{
+        Data:    resp.Data.Data,
+        Status:  resp.Status,
+        Headers: resp.Headers,
+        Success: resp.Success,
+    }, nil
+}
@@ -0,0 +1,70 @@
+// packages/go/retry.go
+package nestjs_sdk
+
+import (
+    "math"
+    "math/rand"
+    "net/http"
+    "time"
+)
+
+// RetryPolicy controls how makeRequest retries a failed attempt: capped
+// exponential backoff between MinRetryDelay and MaxRetryDelay, optional
+// jitter, and an overridable Retryable hook.
+type RetryPolicy struct {
+    MaxRetries    int
+    MinRetryDelay time.Duration
+    MaxRetryDelay time.Duration
+    BackoffFactor float64
+    Jitter        bool
+
+    // Retryable, if set, overrides the default idempotency-aware policy
+    // below. resp is nil when err is a transport error.
+    Retryable func(resp *http.Response, err error) bool
+}
+
+// delay computes the backoff for the given zero-based attempt as
+// min(MaxRetryDelay, MinRetryDelay * BackoffFactor^attempt), plus up to 50%
+// jitter when enabled.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+    factor := p.BackoffFactor
+    if factor <= 0 {
+        factor = 2
+    }
+
+    d := float64(p.MinRetryDelay) * math.Pow(factor, float64(attempt))
+    if p.MaxRetryDelay > 0 && d > float64(p.MaxRetryDelay) {
+        d = float64(p.MaxRetryDelay)
+    }
+
+    delay := time.Duration(d)
+    if p.Jitter && delay > 0 {
+        delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+    }
+    return delay
+}
+
+// retryable reports whether a request made with method should be retried
+// given the observed response (nil on transport error) and error.
+//
+// Connection errors occur before any response is received, so they are
+// retryable regardless of method. Once a response has come back, only the
+// idempotent methods (GET, PUT, DELETE) are retried on 5xx/429 -- retrying
+// POST/PATCH after the server has already processed the request risks
+// duplicating a non-idempotent write.
+func (p RetryPolicy) retryable(method string, resp *http.Response, err error) bool {
+    if p.Retryable != nil {
+        return p.Retryable(resp, err)
+    }
+
+    if err != nil {
+        return true
+    }
+
+    idempotent := method == http.MethodGet || method == http.MethodPut || method == http.MethodDelete
+    if !idempotent {
+        return false
+    }
+
+    return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
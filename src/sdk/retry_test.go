@@ -0,0 +1,136 @@
+// packages/go/retry_test.go
+package nestjs_sdk
+
+import (
+    "errors"
+    "net/http"
+    "testing"
+    "time"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+    tests := []struct {
+        name    string
+        policy  RetryPolicy
+        attempt int
+        want    time.Duration
+    }{
+        {
+            name:    "first attempt uses MinRetryDelay",
+            policy:  RetryPolicy{MinRetryDelay: time.Second, MaxRetryDelay: time.Minute, BackoffFactor: 2},
+            attempt: 0,
+            want:    time.Second,
+        },
+        {
+            name:    "second attempt doubles",
+            policy:  RetryPolicy{MinRetryDelay: time.Second, MaxRetryDelay: time.Minute, BackoffFactor: 2},
+            attempt: 1,
+            want:    2 * time.Second,
+        },
+        {
+            name:    "third attempt quadruples",
+            policy:  RetryPolicy{MinRetryDelay: time.Second, MaxRetryDelay: time.Minute, BackoffFactor: 2},
+            attempt: 2,
+            want:    4 * time.Second,
+        },
+        {
+            name:    "capped at MaxRetryDelay",
+            policy:  RetryPolicy{MinRetryDelay: time.Second, MaxRetryDelay: 3 * time.Second, BackoffFactor: 2},
+            attempt: 5,
+            want:    3 * time.Second,
+        },
+        {
+            name:    "zero BackoffFactor defaults to 2",
+            policy:  RetryPolicy{MinRetryDelay: time.Second, MaxRetryDelay: time.Minute},
+            attempt: 1,
+            want:    2 * time.Second,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := tt.policy.delay(tt.attempt)
+            if got != tt.want {
+                t.Errorf("delay(%d) = %v, want %v", tt.attempt, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestRetryPolicyDelayJitter(t *testing.T) {
+    policy := RetryPolicy{MinRetryDelay: 10 * time.Second, MaxRetryDelay: time.Minute, BackoffFactor: 2, Jitter: true}
+
+    for i := 0; i < 50; i++ {
+        got := policy.delay(0)
+        if got < 10*time.Second || got > 15*time.Second {
+            t.Fatalf("delay with jitter = %v, want in [10s, 15s]", got)
+        }
+    }
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+    resp := func(status int) *http.Response {
+        return &http.Response{StatusCode: status}
+    }
+
+    tests := []struct {
+        name   string
+        method string
+        resp   *http.Response
+        err    error
+        want   bool
+    }{
+        {"transport error is always retryable", http.MethodPost, nil, errors.New("transport error"), true},
+        {"GET 500 is retryable", http.MethodGet, resp(500), nil, true},
+        {"GET 429 is retryable", http.MethodGet, resp(429), nil, true},
+        {"GET 404 is not retryable", http.MethodGet, resp(404), nil, false},
+        {"PUT 503 is retryable", http.MethodPut, resp(503), nil, true},
+        {"DELETE 502 is retryable", http.MethodDelete, resp(502), nil, true},
+        {"POST 500 is not retryable (non-idempotent)", http.MethodPost, resp(500), nil, false},
+        {"PATCH 500 is not retryable (non-idempotent)", http.MethodPatch, resp(500), nil, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            p := RetryPolicy{}
+            if got := p.retryable(tt.method, tt.resp, tt.err); got != tt.want {
+                t.Errorf("retryable(%s, %v, %v) = %v, want %v", tt.method, tt.resp, tt.err, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestRetryPolicyRetryableOverride(t *testing.T) {
+    p := RetryPolicy{
+        Retryable: func(resp *http.Response, err error) bool {
+            return resp != nil && resp.StatusCode == 418
+        },
+    }
+
+    if !p.retryable(http.MethodPost, &http.Response{StatusCode: 418}, nil) {
+        t.Error("custom Retryable hook should override the default idempotency policy")
+    }
+    if p.retryable(http.MethodGet, &http.Response{StatusCode: 500}, nil) {
+        t.Error("custom Retryable hook should suppress the default 5xx retry")
+    }
+}
+
+func TestNewApiClientExplicitZeroMaxRetriesIsRespected(t *testing.T) {
+    client := NewApiClient(ApiClientConfig{
+        RetryPolicy: &RetryPolicy{MaxRetries: 0},
+    })
+
+    if client.retryPolicy.MaxRetries != 0 {
+        t.Errorf("retryPolicy.MaxRetries = %d, want 0 (explicit RetryPolicy must not be overridden by the Retries default)", client.retryPolicy.MaxRetries)
+    }
+}
+
+func TestNewApiClientNilRetryPolicyFallsBackToRetries(t *testing.T) {
+    client := NewApiClient(ApiClientConfig{
+        Retries: 5,
+    })
+
+    if client.retryPolicy.MaxRetries != 5 {
+        t.Errorf("retryPolicy.MaxRetries = %d, want 5 (from Retries, since no RetryPolicy was supplied)", client.retryPolicy.MaxRetries)
+    }
+}
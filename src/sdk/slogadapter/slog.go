@@ -0,0 +1,41 @@
+// packages/go/slogadapter/slog.go
+// Package slogadapter adapts *slog.Logger to nestjs_sdk.Logger. It is a
+// separate package, alongside logrusadapter, so the core SDK stays a thin
+// HTTP wrapper and callers opt into a specific logging backend explicitly.
+package slogadapter
+
+import (
+    "fmt"
+    "log/slog"
+
+    nestjs "your-org/nestjs-api-sdk"
+)
+
+// Logger adapts a *slog.Logger to the nestjs_sdk.Logger interface so SDK
+// events flow into a caller's existing structured logging pipeline.
+type Logger struct {
+    L *slog.Logger
+}
+
+// New wraps l as a nestjs_sdk.Logger.
+func New(l *slog.Logger) *Logger {
+    return &Logger{L: l}
+}
+
+func (s *Logger) Debugf(format string, args ...interface{}) {
+    s.L.Debug(fmt.Sprintf(format, args...))
+}
+
+func (s *Logger) Infof(format string, args ...interface{}) {
+    s.L.Info(fmt.Sprintf(format, args...))
+}
+
+func (s *Logger) Warnf(format string, args ...interface{}) {
+    s.L.Warn(fmt.Sprintf(format, args...))
+}
+
+func (s *Logger) Errorf(format string, args ...interface{}) {
+    s.L.Error(fmt.Sprintf(format, args...))
+}
+
+var _ nestjs.Logger = (*Logger)(nil)
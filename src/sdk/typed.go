@@ -0,0 +1,61 @@
+// packages/go/typed.go
+package nestjs_sdk
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+)
+
+// Response is the generic counterpart to ApiResponse: Data is decoded
+// directly into T via json.Decoder instead of interface{}, so callers no
+// longer need a type assertion to use it. Prefer Get/Post/Put/Patch/Delete
+// over ApiClient's untyped methods when the response shape is known.
+type Response[T any] struct {
+    Data    T
+    Status  int
+    Headers map[string]string
+    Success bool
+}
+
+// Get issues a GET to endpoint and decodes the response body directly into T.
+func Get[T any](ctx context.Context, c *ApiClient, endpoint string) (*Response[T], error) {
+    return typedRequest[T](ctx, c, http.MethodGet, endpoint, nil)
+}
+
+// Post issues a POST to endpoint and decodes the response body directly into T.
+func Post[T any](ctx context.Context, c *ApiClient, endpoint string, body interface{}) (*Response[T], error) {
+    return typedRequest[T](ctx, c, http.MethodPost, endpoint, body)
+}
+
+// Put issues a PUT to endpoint and decodes the response body directly into T.
+func Put[T any](ctx context.Context, c *ApiClient, endpoint string, body interface{}) (*Response[T], error) {
+    return typedRequest[T](ctx, c, http.MethodPut, endpoint, body)
+}
+
+// Patch issues a PATCH to endpoint and decodes the response body directly into T.
+func Patch[T any](ctx context.Context, c *ApiClient, endpoint string, body interface{}) (*Response[T], error) {
+    return typedRequest[T](ctx, c, http.MethodPatch, endpoint, body)
+}
+
+// Delete issues a DELETE to endpoint and decodes the response body directly into T.
+func Delete[T any](ctx context.Context, c *ApiClient, endpoint string) (*Response[T], error) {
+    return typedRequest[T](ctx, c, http.MethodDelete, endpoint, nil)
+}
+
+// typedRequest shares ApiClient's retry/auth/rate-limit/logging pipeline via
+// execute, decoding a successful body straight into T with json.Decoder
+// instead of reading it fully and unmarshaling into interface{} first.
+func typedRequest[T any](ctx context.Context, c *ApiClient, method, endpoint string, body interface{}) (*Response[T], error) {
+    return execute[T](ctx, c, method, endpoint, body, func(resp *http.Response) (T, []byte, error) {
+        var data T
+        if resp.ContentLength != 0 {
+            if err := json.NewDecoder(resp.Body).Decode(&data); err != nil && err != io.EOF {
+                return data, nil, fmt.Errorf("failed to decode response into %T: %w", data, err)
+            }
+        }
+        return data, nil, nil
+    })
+}
@@ -0,0 +1,67 @@
+// packages/go/users.go
+package nestjs_sdk
+
+import "context"
+
+// User is the NestJS backend's Users module representation of an account.
+type User struct {
+    ID    string `json:"id"`
+    Name  string `json:"name"`
+    Email string `json:"email"`
+}
+
+// CreateUserRequest is the payload accepted by UsersResource.Create.
+type CreateUserRequest struct {
+    Name     string `json:"name"`
+    Email    string `json:"email"`
+    Password string `json:"password"`
+}
+
+// UpdateUserRequest is the payload accepted by UsersResource.Update. Omitted
+// fields are left unset in the request body so partial updates don't
+// clobber values the caller didn't intend to change.
+type UpdateUserRequest struct {
+    Name  string `json:"name,omitempty"`
+    Email string `json:"email,omitempty"`
+}
+
+// UsersResource is the sub-client for the NestJS backend's Users module.
+type UsersResource struct {
+    client *ApiClient
+}
+
+// NewUsersResource builds a UsersResource bound to client.
+func NewUsersResource(client *ApiClient) *UsersResource {
+    return &UsersResource{client: client}
+}
+
+// List returns one page of users matching opts.
+func (r *UsersResource) List(ctx context.Context, opts ListOpts) (*Response[[]User], error) {
+    return getList[User](ctx, r.client, withListOpts("/users", opts))
+}
+
+// ListAll streams every page of users matching opts.
+func (r *UsersResource) ListAll(ctx context.Context, opts ListOptions) <-chan Page[User] {
+    paginator := NewPaginator[User](r.client, "/users", PageNumberStrategy[User]{ItemsKey: "data"})
+    return paginator.Stream(ctx, opts)
+}
+
+// Get fetches a single user by ID.
+func (r *UsersResource) Get(ctx context.Context, id string) (*Response[User], error) {
+    return Get[User](ctx, r.client, "/users/"+id)
+}
+
+// Create registers a new user.
+func (r *UsersResource) Create(ctx context.Context, req CreateUserRequest) (*Response[User], error) {
+    return Post[User](ctx, r.client, "/users", req)
+}
+
+// Update applies a partial update to a user.
+func (r *UsersResource) Update(ctx context.Context, id string, req UpdateUserRequest) (*Response[User], error) {
+    return Patch[User](ctx, r.client, "/users/"+id, req)
+}
+
+// Delete removes a user.
+func (r *UsersResource) Delete(ctx context.Context, id string) (*Response[struct{}], error) {
+    return Delete[struct{}](ctx, r.client, "/users/"+id)
+}